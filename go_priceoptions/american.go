@@ -0,0 +1,239 @@
+package go_priceoptions
+
+import (
+	"errors"
+	"math"
+)
+
+// PriceCRRBinomial prices an American option with a Cox–Ross–Rubinstein
+// binomial tree of the given number of steps. At every node it takes
+// max(continuation, intrinsic) so early exercise is reflected throughout the
+// backward induction.
+func PriceCRRBinomial(callType bool, underlying float64, strike float64, timeToExpiration float64, volatility float64, riskFreeInterest float64, dividend float64, steps int) float64 {
+	dt := timeToExpiration / float64(steps)
+	u := math.Exp(volatility * math.Sqrt(dt))
+	d := 1 / u
+	p := (math.Exp((riskFreeInterest-dividend)*dt) - d) / (u - d)
+	discount := math.Exp(-riskFreeInterest * dt)
+
+	intrinsic := func(spot float64) float64 {
+		if callType {
+			return math.Max(0, spot-strike)
+		}
+		return math.Max(0, strike-spot)
+	}
+
+	// Terminal payoffs, node i has i down-moves out of steps total moves.
+	values := make([]float64, steps+1)
+	for i := 0; i <= steps; i++ {
+		spot := underlying * math.Pow(u, float64(steps-i)) * math.Pow(d, float64(i))
+		values[i] = intrinsic(spot)
+	}
+
+	for step := steps - 1; step >= 0; step-- {
+		for i := 0; i <= step; i++ {
+			continuation := discount * (p*values[i] + (1-p)*values[i+1])
+			spot := underlying * math.Pow(u, float64(step-i)) * math.Pow(d, float64(i))
+			values[i] = math.Max(continuation, intrinsic(spot))
+		}
+	}
+
+	return values[0]
+}
+
+// PriceBjerksundStensland2002 approximates the American option price in
+// closed form using the Bjerksund–Stensland (2002) two-exercise-boundary
+// model. It is much cheaper than PriceCRRBinomial and is the preferred
+// pricer for batch/vectorized use.
+func PriceBjerksundStensland2002(callType bool, underlying float64, strike float64, timeToExpiration float64, volatility float64, riskFreeInterest float64, dividend float64) float64 {
+	if !callType {
+		// Bjerksund–Stensland put-call transformation: an American put is an
+		// American call with the underlying/strike and rate/dividend pairs
+		// swapped (equivalent to r' = r-b, b' = -b in cost-of-carry terms).
+		return PriceBjerksundStensland2002(true, strike, underlying, timeToExpiration, volatility, dividend, riskFreeInterest)
+	}
+
+	b := riskFreeInterest - dividend
+	v2 := volatility * volatility
+
+	if b >= riskFreeInterest {
+		// No early-exercise premium when carry dominates the discount rate;
+		// the American call equals the European call.
+		return PriceBlackScholes(true, underlying, strike, timeToExpiration, volatility, riskFreeInterest, dividend)
+	}
+
+	t1 := 0.5 * (math.Sqrt(5) - 1) * timeToExpiration
+
+	beta := (0.5 - b/v2) + math.Sqrt(math.Pow(b/v2-0.5, 2)+2*riskFreeInterest/v2)
+	bInfinity := beta / (beta - 1) * strike
+	b0 := math.Max(strike, riskFreeInterest/(riskFreeInterest-b)*strike)
+
+	ht1 := -(b*t1 + 2*volatility*math.Sqrt(t1)) * strike * strike / ((bInfinity - b0) * b0)
+	ht2 := -(b*timeToExpiration + 2*volatility*math.Sqrt(timeToExpiration)) * strike * strike / ((bInfinity - b0) * b0)
+
+	i1 := b0 + (bInfinity-b0)*(1-math.Exp(ht1))
+	i2 := b0 + (bInfinity-b0)*(1-math.Exp(ht2))
+
+	alpha1 := (i1 - strike) * math.Pow(i1, -beta)
+	alpha2 := (i2 - strike) * math.Pow(i2, -beta)
+
+	if underlying >= i2 {
+		return underlying - strike
+	}
+
+	return alpha2*math.Pow(underlying, beta) -
+		alpha2*phiBS(underlying, t1, beta, i2, i2, riskFreeInterest, b, volatility) +
+		phiBS(underlying, t1, 1, i2, i2, riskFreeInterest, b, volatility) -
+		phiBS(underlying, t1, 1, i1, i2, riskFreeInterest, b, volatility) -
+		strike*phiBS(underlying, t1, 0, i2, i2, riskFreeInterest, b, volatility) +
+		strike*phiBS(underlying, t1, 0, i1, i2, riskFreeInterest, b, volatility) +
+		alpha1*phiBS(underlying, t1, beta, i1, i2, riskFreeInterest, b, volatility) -
+		alpha1*psiBS(underlying, timeToExpiration, beta, i2, i2, i1, t1, riskFreeInterest, b, volatility) +
+		psiBS(underlying, timeToExpiration, 1, i2, i2, i1, t1, riskFreeInterest, b, volatility) -
+		psiBS(underlying, timeToExpiration, 1, strike, i2, i1, t1, riskFreeInterest, b, volatility) -
+		strike*psiBS(underlying, timeToExpiration, 0, i2, i2, i1, t1, riskFreeInterest, b, volatility) +
+		strike*psiBS(underlying, timeToExpiration, 0, strike, i2, i1, t1, riskFreeInterest, b, volatility)
+}
+
+// phiBS is the Bjerksund–Stensland helper function used to price a single
+// flat exercise boundary.
+func phiBS(underlying, t, gamma, h, i, r, b, v float64) float64 {
+	lambda := -r + gamma*b + 0.5*gamma*(gamma-1)*v*v
+	d := -(math.Log(underlying/h) + (b+(gamma-0.5)*v*v)*t) / (v * math.Sqrt(t))
+	kappa := 2*b/(v*v) + (2*gamma - 1)
+	return math.Exp(lambda*t) * math.Pow(underlying, gamma) *
+		(NormCdf(d) - math.Pow(i/underlying, kappa)*NormCdf(d-2*math.Log(i/underlying)/(v*math.Sqrt(t))))
+}
+
+// psiBS is the Bjerksund–Stensland (2002) helper function used to price the
+// second, later exercise boundary; it relies on the bivariate normal CDF.
+func psiBS(underlying, t2, gamma, h, i2, i1, t1, r, b, v float64) float64 {
+	vt1 := v * math.Sqrt(t1)
+	vt2 := v * math.Sqrt(t2)
+	drift := b + (gamma-0.5)*v*v
+
+	e1 := (math.Log(underlying/i1) + drift*t1) / vt1
+	e2 := (math.Log(i2*i2/(underlying*i1)) + drift*t1) / vt1
+	e3 := (math.Log(underlying/i1) - drift*t1) / vt1
+	e4 := (math.Log(i2*i2/(underlying*i1)) - drift*t1) / vt1
+
+	f1 := (math.Log(underlying/h) + drift*t2) / vt2
+	f2 := (math.Log(i2*i2/(underlying*h)) + drift*t2) / vt2
+	f3 := (math.Log(i1*i1/(underlying*h)) + drift*t2) / vt2
+	f4 := (math.Log(underlying*i1*i1/(h*i2*i2)) + drift*t2) / vt2
+
+	rho := math.Sqrt(t1 / t2)
+	lambda := -r + gamma*b + 0.5*gamma*(gamma-1)*v*v
+	kappa := 2*b/(v*v) + (2*gamma - 1)
+
+	return math.Exp(lambda*t2) * math.Pow(underlying, gamma) *
+		(bivariateNormalCDF(-e1, -f1, rho) -
+			math.Pow(i2/underlying, kappa)*bivariateNormalCDF(-e2, -f2, rho) -
+			math.Pow(i1/underlying, kappa)*bivariateNormalCDF(-e3, -f3, -rho) +
+			math.Pow(i1/i2, kappa)*bivariateNormalCDF(-e4, -f4, -rho))
+}
+
+// bivariateNormalCDF computes the CDF of a standard bivariate normal
+// distribution with correlation rho, using the identity
+//
+//	Phi2(x, y; rho) = Phi(x)*Phi(y) + integral_0^rho phi2(x, y; t) dt
+//
+// where phi2 is the bivariate normal density, and evaluating the integral
+// with Simpson's rule.
+func bivariateNormalCDF(x, y, rho float64) float64 {
+	if rho == 0 {
+		return NormCdf(x) * NormCdf(y)
+	}
+
+	density := func(t float64) float64 {
+		return math.Exp(-(x*x-2*t*x*y+y*y)/(2*(1-t*t))) / (2 * math.Pi * math.Sqrt(1-t*t))
+	}
+
+	const steps = 200
+	return NormCdf(x)*NormCdf(y) + simpson(density, 0, rho, steps)
+}
+
+// simpson integrates f over [a, b] with Simpson's rule using n sub-intervals
+// (n is rounded up to an even number).
+func simpson(f func(float64) float64, a, b float64, n int) float64 {
+	if n%2 != 0 {
+		n++
+	}
+	h := (b - a) / float64(n)
+	sum := f(a) + f(b)
+	for i := 1; i < n; i++ {
+		x := a + float64(i)*h
+		if i%2 == 0 {
+			sum += 2 * f(x)
+		} else {
+			sum += 4 * f(x)
+		}
+	}
+	return sum * h / 3
+}
+
+// AmericanImpliedVol recovers implied volatility for an American option by
+// running the same Newton/bisection scaffolding as BSImpliedVolNewton, but
+// pricing each candidate volatility with PriceCRRBinomial instead of the
+// European Black–Scholes formula. steps controls the binomial tree's
+// resolution.
+func AmericanImpliedVol(callType bool, lastTradedPrice float64, underlying float64, strike float64, timeToExpiration float64, riskFreeInterest float64, dividend float64, steps int) (float64, int, error) {
+	intrinsic := 0.0
+	if callType {
+		intrinsic = math.Max(0, underlying-strike)
+	} else {
+		intrinsic = math.Max(0, strike-underlying)
+	}
+	if lastTradedPrice < intrinsic-IVPrecision {
+		return math.NaN(), 0, ErrBelowIntrinsic
+	}
+
+	f := func(vol float64) float64 {
+		return PriceCRRBinomial(callType, underlying, strike, timeToExpiration, vol, riskFreeInterest, dividend, steps) - lastTradedPrice
+	}
+
+	lowVol, highVol := 1e-6, newtonVolCap
+	fLow, fHigh := f(lowVol), f(highVol)
+	if fLow*fHigh > 0 {
+		return math.NaN(), 0, ErrNoArbitrageFreeSolution
+	}
+
+	sigma := math.Sqrt(2*math.Pi/timeToExpiration) * lastTradedPrice / underlying
+	if sigma <= 0 || math.IsNaN(sigma) || math.IsInf(sigma, 0) {
+		sigma = (lowVol + highVol) / 2
+	}
+
+	for iter := 0; iter < newtonMaxIter; iter++ {
+		fVal := f(sigma)
+		if math.Abs(fVal) < IVPrecision {
+			return sigma, iter, nil
+		}
+
+		if fLow*fVal <= 0 {
+			highVol, fHigh = sigma, fVal
+		} else {
+			lowVol, fLow = sigma, fVal
+		}
+
+		// The binomial tree has no closed-form derivative, so approximate
+		// vega with a central difference instead of BSVega.
+		const bump = 1e-4
+		vega := (f(sigma+bump) - f(sigma-bump)) / (2 * bump)
+		next := sigma
+		if vega != 0 {
+			next = sigma - fVal/vega
+		}
+
+		if vega == 0 || next <= 0 || next > newtonVolCap || math.Abs(next-sigma) > highVol-lowVol {
+			next = (lowVol + highVol) / 2
+		}
+
+		sigma = next
+	}
+
+	return sigma, newtonMaxIter, ErrDidNotConverge
+}
+
+// ErrInvalidPricingModel is returned when an unrecognized pricing model name
+// is requested.
+var ErrInvalidPricingModel = errors.New("go_priceoptions: unknown pricing model")
@@ -0,0 +1,86 @@
+package go_priceoptions
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCRRAgreesWithBjerksundStensland2002(t *testing.T) {
+	cases := []struct {
+		callType                                                        bool
+		underlying, strike, tte, volatility, riskFreeInterest, dividend float64
+	}{
+		{true, 100, 100, 1.0, 0.20, 0.05, 0.02},
+		{false, 100, 100, 1.0, 0.20, 0.05, 0.02},
+		{false, 228, 247.5, 0.1, 0.30, 0.0432623312, 0.0},
+	}
+
+	for _, c := range cases {
+		binom := PriceCRRBinomial(c.callType, c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend, 500)
+		closedForm := PriceBjerksundStensland2002(c.callType, c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend)
+
+		// Bjerksund-Stensland 2002 is a closed-form approximation, not an
+		// exact match for the binomial tree, so allow a couple percent of
+		// relative error.
+		if diff := math.Abs(binom - closedForm); diff > 0.02*binom {
+			t.Errorf("PriceCRRBinomial(%+v) = %.4f, PriceBjerksundStensland2002 = %.4f, diff %.4f exceeds tolerance", c, binom, closedForm, diff)
+		}
+	}
+}
+
+func TestAmericanCallEqualsEuropeanCallWithNoDividend(t *testing.T) {
+	// With no dividend, early exercise of an American call is never optimal,
+	// so both American pricers should match the European Black-Scholes
+	// price.
+	underlying, strike, tte, volatility, r, q := 100.0, 100.0, 1.0, 0.25, 0.05, 0.0
+
+	euro := PriceBlackScholes(true, underlying, strike, tte, volatility, r, q)
+	binom := PriceCRRBinomial(true, underlying, strike, tte, volatility, r, q, 500)
+	closedForm := PriceBjerksundStensland2002(true, underlying, strike, tte, volatility, r, q)
+
+	if diff := math.Abs(binom - euro); diff > 0.05 {
+		t.Errorf("PriceCRRBinomial call with no dividend = %.4f, want close to European price %.4f", binom, euro)
+	}
+	if diff := math.Abs(closedForm - euro); diff > 1e-9 {
+		t.Errorf("PriceBjerksundStensland2002 call with no dividend = %.4f, want exactly the European price %.4f", closedForm, euro)
+	}
+}
+
+func TestDeepITMAmericanPutEarlyExercisePremium(t *testing.T) {
+	// A deep in-the-money American put on a non-dividend-paying stock has a
+	// real early-exercise premium: it should be worth strictly more than the
+	// otherwise-identical European put, and close to its intrinsic value.
+	underlying, strike, tte, volatility, r, q := 50.0, 150.0, 1.0, 0.20, 0.05, 0.0
+	intrinsic := strike - underlying
+
+	euro := PriceBlackScholes(false, underlying, strike, tte, volatility, r, q)
+	binom := PriceCRRBinomial(false, underlying, strike, tte, volatility, r, q, 500)
+
+	if binom <= euro {
+		t.Errorf("deep ITM American put (CRR) = %.4f, want strictly greater than European price %.4f", binom, euro)
+	}
+	if binom < intrinsic {
+		t.Errorf("deep ITM American put (CRR) = %.4f, want at least intrinsic value %.4f", binom, intrinsic)
+	}
+	if diff := math.Abs(binom - intrinsic); diff > 1.0 {
+		t.Errorf("deep ITM American put (CRR) = %.4f, want close to intrinsic value %.4f", binom, intrinsic)
+	}
+}
+
+func TestAmericanImpliedVolConverges(t *testing.T) {
+	underlying, strike, tte, trueVol, r, q := 228.0, 220.0, 0.5, 0.30, 0.0432623312, 0.01
+	steps := 200
+
+	price := PriceCRRBinomial(false, underlying, strike, tte, trueVol, r, q, steps)
+
+	iv, iterations, err := AmericanImpliedVol(false, price, underlying, strike, tte, r, q, steps)
+	if err != nil {
+		t.Fatalf("AmericanImpliedVol returned error: %v", err)
+	}
+	if iterations >= newtonMaxIter {
+		t.Errorf("AmericanImpliedVol used the full iteration budget")
+	}
+	if diff := math.Abs(iv - trueVol); diff > 1e-2 {
+		t.Errorf("AmericanImpliedVol = %.6f, want close to %.6f", iv, trueVol)
+	}
+}
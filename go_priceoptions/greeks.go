@@ -0,0 +1,91 @@
+package go_priceoptions
+
+import (
+	"math"
+	"runtime"
+	"sync"
+)
+
+// Greeks bundles an option's price and all five Greeks computed together,
+// as returned by ComputeAll.
+type Greeks struct {
+	Price, Delta, Gamma, Vega, Theta, Rho float64
+}
+
+// ComputeAll computes the price and all five Greeks in one pass, reusing the
+// shared intermediate terms (sqrt(T), discount factors, d1/d2, and the
+// normal PDF/CDF) that BSDelta, BSVega, BSGamma, BSTheta, and BSRho would
+// otherwise each recompute independently. Results match calling those
+// functions individually.
+func ComputeAll(callType bool, underlying float64, strike float64, timeToExpiration float64, volatility float64, riskFreeInterest float64, dividend float64) Greeks {
+	sqt := math.Sqrt(timeToExpiration)
+	vt := volatility * sqt
+	re := math.Exp(-riskFreeInterest * timeToExpiration)
+	qe := math.Exp(-dividend * timeToExpiration)
+	d1 := d1f(underlying, strike, timeToExpiration, volatility, riskFreeInterest, dividend, vt)
+	d2 := d2f(d1, vt)
+	d1pdf := math.Exp(-0.5*d1*d1) / sqtwopi
+
+	var sign, zo float64
+	if callType {
+		sign, zo = 1, 0
+	} else {
+		sign, zo = -1, -1
+	}
+
+	nd1 := NormCdf(sign * d1)
+	nd2 := NormCdf(sign * d2)
+
+	price := sign * ((underlying * qe * nd1) - (strike * re * nd2))
+	delta := qe * (NormCdf(d1) + zo)
+	gamma := (qe / (underlying * volatility * sqt)) * d1pdf
+	vega := d1pdf * qe * underlying * sqt * 0.01
+	theta := (-1*((underlying*volatility*qe)/(2*sqt))*d1pdf -
+		sign*riskFreeInterest*strike*re*nd2 +
+		sign*dividend*underlying*qe*nd1) / 365
+	rho := sign * (strike * timeToExpiration * re) / 100 * nd2
+
+	return Greeks{Price: price, Delta: delta, Gamma: gamma, Vega: vega, Theta: theta, Rho: rho}
+}
+
+// ChainInput is one option's parameters for a ComputeChain batch.
+type ChainInput struct {
+	CallType                                                                     bool
+	Underlying, Strike, TimeToExpiration, Volatility, RiskFreeInterest, Dividend float64
+}
+
+// ComputeChain computes Greeks for a batch of options, parallelizing the
+// work over runtime.NumCPU() workers. It is intended for scanning a full
+// option chain, where per-function Greek calls would otherwise recompute
+// d1/d2 and the discount factors for every strike and expiry.
+func ComputeChain(inputs []ChainInput) []Greeks {
+	results := make([]Greeks, len(inputs))
+	if len(inputs) == 0 {
+		return results
+	}
+
+	workers := runtime.NumCPU()
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				in := inputs[i]
+				results[i] = ComputeAll(in.CallType, in.Underlying, in.Strike, in.TimeToExpiration, in.Volatility, in.RiskFreeInterest, in.Dividend)
+			}
+		}()
+	}
+	for i := range inputs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,89 @@
+package go_priceoptions
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComputeAllMatchesPerFunction(t *testing.T) {
+	cases := []struct {
+		callType                                                        bool
+		underlying, strike, tte, volatility, riskFreeInterest, dividend float64
+	}{
+		{true, 228, 230, 0.25, 0.20, 0.04, 0.0},
+		{false, 228, 220, 0.50, 0.30, 0.0432623312, 0.01},
+	}
+
+	for _, c := range cases {
+		got := ComputeAll(c.callType, c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend)
+
+		want := Greeks{
+			Price: PriceBlackScholes(c.callType, c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend),
+			Delta: BSDelta(c.callType, c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend),
+			Gamma: BSGamma(c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend),
+			Vega:  BSVega(c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend),
+			Theta: BSTheta(c.callType, c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend),
+			Rho:   BSRho(c.callType, c.underlying, c.strike, c.tte, c.volatility, c.riskFreeInterest, c.dividend),
+		}
+
+		if !greeksClose(got, want) {
+			t.Errorf("ComputeAll(%+v) = %+v, want %+v", c, got, want)
+		}
+	}
+}
+
+func TestComputeChainMatchesComputeAll(t *testing.T) {
+	inputs := []ChainInput{
+		{CallType: true, Underlying: 228, Strike: 230, TimeToExpiration: 0.25, Volatility: 0.2, RiskFreeInterest: 0.04, Dividend: 0.0},
+		{CallType: false, Underlying: 228, Strike: 220, TimeToExpiration: 0.5, Volatility: 0.3, RiskFreeInterest: 0.0432623312, Dividend: 0.01},
+	}
+
+	got := ComputeChain(inputs)
+	for i, in := range inputs {
+		want := ComputeAll(in.CallType, in.Underlying, in.Strike, in.TimeToExpiration, in.Volatility, in.RiskFreeInterest, in.Dividend)
+		if !greeksClose(got[i], want) {
+			t.Errorf("ComputeChain[%d] = %+v, want %+v", i, got[i], want)
+		}
+	}
+}
+
+func greeksClose(a, b Greeks) bool {
+	const tol = 1e-9
+	return math.Abs(a.Price-b.Price) < tol &&
+		math.Abs(a.Delta-b.Delta) < tol &&
+		math.Abs(a.Gamma-b.Gamma) < tol &&
+		math.Abs(a.Vega-b.Vega) < tol &&
+		math.Abs(a.Theta-b.Theta) < tol &&
+		math.Abs(a.Rho-b.Rho) < tol
+}
+
+func BenchmarkPerFunctionGreeks(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = PriceBlackScholes(true, 228, 230, 0.25, 0.2, 0.04, 0.0)
+		_ = BSDelta(true, 228, 230, 0.25, 0.2, 0.04, 0.0)
+		_ = BSGamma(228, 230, 0.25, 0.2, 0.04, 0.0)
+		_ = BSVega(228, 230, 0.25, 0.2, 0.04, 0.0)
+		_ = BSTheta(true, 228, 230, 0.25, 0.2, 0.04, 0.0)
+		_ = BSRho(true, 228, 230, 0.25, 0.2, 0.04, 0.0)
+	}
+}
+
+func BenchmarkComputeAll(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = ComputeAll(true, 228, 230, 0.25, 0.2, 0.04, 0.0)
+	}
+}
+
+func BenchmarkComputeChain(b *testing.B) {
+	inputs := make([]ChainInput, 200)
+	for i := range inputs {
+		inputs[i] = ChainInput{
+			CallType: i%2 == 0, Underlying: 228, Strike: 200 + float64(i),
+			TimeToExpiration: 0.25, Volatility: 0.2, RiskFreeInterest: 0.04, Dividend: 0.0,
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = ComputeChain(inputs)
+	}
+}
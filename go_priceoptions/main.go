@@ -1,6 +1,7 @@
 package go_priceoptions
 
 import (
+	"errors"
 	"math"
 )
 
@@ -8,6 +9,27 @@ import (
 var sqtwopi float64 = math.Sqrt(2 * math.Pi)
 var IVPrecision float64 = 1e-6 // tolerance for the bisection method
 
+// Sentinel errors returned by BSImpliedVolNewton.
+var (
+	// ErrBelowIntrinsic is returned when lastTradedPrice is below the option's
+	// intrinsic value, so no volatility can reproduce it.
+	ErrBelowIntrinsic = errors.New("go_priceoptions: market price is below intrinsic value")
+	// ErrDidNotConverge is returned when the solver exhausts its iteration
+	// budget without reaching the requested tolerance.
+	ErrDidNotConverge = errors.New("go_priceoptions: implied volatility solver did not converge")
+	// ErrNoArbitrageFreeSolution is returned when no volatility in the valid
+	// range reproduces lastTradedPrice (e.g. the bisection bracket has no
+	// sign change).
+	ErrNoArbitrageFreeSolution = errors.New("go_priceoptions: no arbitrage-free volatility solves for the given price")
+)
+
+// newtonMaxIter bounds BSImpliedVolNewton's iteration budget.
+const newtonMaxIter = 100
+
+// newtonVolCap is the highest volatility the Newton step is allowed to
+// propose before we fall back to bisection.
+const newtonVolCap = 5.0
+
 // NormCdf computes the cumulative distribution function for a standard normal distribution.
 func NormCdf(x float64) float64 {
 	return 0.5 * (1 + math.Erf(x/math.Sqrt2))
@@ -145,44 +167,81 @@ func BSRho(callType bool, underlying float64, strike float64, timeToExpiration f
 	return rho
 }
 
-// BSImpliedVol computes the implied volatility using a bracketed solver (bisection method).
-// It brackets the volatility between lowVol and highVol and then finds the root of
-// f(vol) = PriceBlackScholes(vol) - lastTradedPrice.
-func BSImpliedVol(callType bool, lastTradedPrice float64, underlying float64, strike float64, timeToExpiration float64, startAnchorVolatility float64, riskFreeInterest float64, dividend float64) float64 {
-	// --- Intrinsic check removed to allow solving even if market price is below the typical lower bound ---
-
-	// Set volatility bracket.
-	lowVol := 1e-6
-	highVol := 5.0
+// BSImpliedVolNewton computes the implied volatility with Newton–Raphson,
+// using the analytic vega from BSVega as the derivative and falling back to
+// a bisection step whenever the Newton update misbehaves. It returns the
+// solved volatility, the number of iterations taken, and an error describing
+// why no volatility could be found.
+//
+// The initial guess is seeded with the Brenner–Subrahmanyan approximation
+// sigma0 = sqrt(2*pi/T) * price/underlying rather than a caller-supplied
+// anchor, since it is usually close enough to make the fallback bisection
+// steps rare.
+func BSImpliedVolNewton(callType bool, lastTradedPrice float64, underlying float64, strike float64, timeToExpiration float64, riskFreeInterest float64, dividend float64) (float64, int, error) {
+	var intrinsic float64
+	if callType {
+		intrinsic = math.Max(0, underlying*math.Exp(-dividend*timeToExpiration)-strike*math.Exp(-riskFreeInterest*timeToExpiration))
+	} else {
+		intrinsic = math.Max(0, strike*math.Exp(-riskFreeInterest*timeToExpiration)-underlying*math.Exp(-dividend*timeToExpiration))
+	}
+	if lastTradedPrice < intrinsic-IVPrecision {
+		return math.NaN(), 0, ErrBelowIntrinsic
+	}
 
-	// f(vol) is the difference between the theoretical price and the market price.
 	f := func(vol float64) float64 {
 		return PriceBlackScholes(callType, underlying, strike, timeToExpiration, vol, riskFreeInterest, dividend) - lastTradedPrice
 	}
 
-	fLow := f(lowVol)
-	fHigh := f(highVol)
+	// Bracket for the bisection fallback.
+	lowVol, highVol := 1e-6, newtonVolCap
+	fLow, fHigh := f(lowVol), f(highVol)
 	if fLow*fHigh > 0 {
-		// If no sign change exists in the bracket, we cannot reliably find a root.
-		return math.NaN()
+		return math.NaN(), 0, ErrNoArbitrageFreeSolution
+	}
+
+	sigma := math.Sqrt(2*math.Pi/timeToExpiration) * lastTradedPrice / underlying
+	if sigma <= 0 || math.IsNaN(sigma) || math.IsInf(sigma, 0) {
+		sigma = (lowVol + highVol) / 2
 	}
 
-	// Use the bisection method to find the root.
-	midVol := 0.0
-	for i := 0; i < 100; i++ {
-		midVol = (lowVol + highVol) / 2
-		fMid := f(midVol)
-		if math.Abs(fMid) < IVPrecision {
-			return midVol
+	for i := 0; i < newtonMaxIter; i++ {
+		fVal := f(sigma)
+		if math.Abs(fVal) < IVPrecision {
+			return sigma, i, nil
 		}
-		if fLow*fMid < 0 {
-			highVol = midVol
-			fHigh = fMid
+
+		// Keep the bisection bracket centered on the current sign change.
+		if fLow*fVal <= 0 {
+			highVol, fHigh = sigma, fVal
 		} else {
-			lowVol = midVol
-			fLow = fMid
+			lowVol, fLow = sigma, fVal
+		}
+
+		vega := BSVega(underlying, strike, timeToExpiration, sigma, riskFreeInterest, dividend) * 100
+		next := sigma
+		if vega != 0 {
+			next = sigma - fVal/vega
+		}
+
+		if vega == 0 || next <= 0 || next > newtonVolCap || math.Abs(next-sigma) > highVol-lowVol {
+			// Newton step is unusable; fall back to a bisection step.
+			next = (lowVol + highVol) / 2
 		}
+
+		sigma = next
 	}
 
-	return midVol
+	return sigma, newtonMaxIter, ErrDidNotConverge
+}
+
+// BSImpliedVol computes the implied volatility. It is a thin wrapper around
+// BSImpliedVolNewton kept for backward compatibility with callers that expect
+// the old bisection-based signature; startAnchorVolatility is accepted but
+// unused since BSImpliedVolNewton seeds its own initial guess.
+func BSImpliedVol(callType bool, lastTradedPrice float64, underlying float64, strike float64, timeToExpiration float64, startAnchorVolatility float64, riskFreeInterest float64, dividend float64) float64 {
+	iv, _, err := BSImpliedVolNewton(callType, lastTradedPrice, underlying, strike, timeToExpiration, riskFreeInterest, dividend)
+	if err != nil {
+		return math.NaN()
+	}
+	return iv
 }
@@ -0,0 +1,63 @@
+package go_priceoptions
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBSImpliedVolNewtonConverges(t *testing.T) {
+	cases := []struct {
+		callType                                                     bool
+		underlying, strike, tte, trueVol, riskFreeInterest, dividend float64
+	}{
+		{true, 100, 100, 1.0, 0.20, 0.05, 0.0},
+		{false, 100, 100, 1.0, 0.20, 0.05, 0.0},
+		{true, 228, 230, 0.25, 0.35, 0.0432623312, 0.0},
+		{false, 228, 180, 0.5, 0.50, 0.0432623312, 0.01},
+	}
+
+	for _, c := range cases {
+		price := PriceBlackScholes(c.callType, c.underlying, c.strike, c.tte, c.trueVol, c.riskFreeInterest, c.dividend)
+
+		iv, iterations, err := BSImpliedVolNewton(c.callType, price, c.underlying, c.strike, c.tte, c.riskFreeInterest, c.dividend)
+		if err != nil {
+			t.Fatalf("BSImpliedVolNewton(%+v) returned error: %v", c, err)
+		}
+		if iterations >= newtonMaxIter {
+			t.Errorf("BSImpliedVolNewton(%+v) used the full iteration budget", c)
+		}
+		if math.Abs(iv-c.trueVol) > 1e-3 {
+			t.Errorf("BSImpliedVolNewton(%+v) = %.6f, want close to %.6f", c, iv, c.trueVol)
+		}
+	}
+}
+
+func TestBSImpliedVolNewtonBelowIntrinsic(t *testing.T) {
+	// A call struck at 100 with the underlying at 120 has intrinsic value
+	// 20; quoting a price below that is not an arbitrage-free market.
+	_, _, err := BSImpliedVolNewton(true, 5.0, 120, 100, 1.0, 0.05, 0.0)
+	if err != ErrBelowIntrinsic {
+		t.Errorf("BSImpliedVolNewton returned err = %v, want ErrBelowIntrinsic", err)
+	}
+}
+
+func TestBSImpliedVolNewtonNoArbitrageFreeSolution(t *testing.T) {
+	// No volatility in the bracket can produce a price this far above the
+	// theoretical maximum, so the bisection bracket has no sign change.
+	_, _, err := BSImpliedVolNewton(true, 1e6, 100, 100, 1.0, 0.05, 0.0)
+	if err != ErrNoArbitrageFreeSolution {
+		t.Errorf("BSImpliedVolNewton returned err = %v, want ErrNoArbitrageFreeSolution", err)
+	}
+}
+
+func TestBSImpliedVolWrapsNewton(t *testing.T) {
+	price := PriceBlackScholes(true, 100, 100, 1.0, 0.25, 0.05, 0.0)
+	iv := BSImpliedVol(true, price, 100, 100, 1.0, 0.23, 0.05, 0.0)
+	if math.Abs(iv-0.25) > 1e-3 {
+		t.Errorf("BSImpliedVol = %.6f, want close to 0.25", iv)
+	}
+
+	if iv := BSImpliedVol(true, 1e6, 100, 100, 1.0, 0.23, 0.05, 0.0); !math.IsNaN(iv) {
+		t.Errorf("BSImpliedVol for an unsolvable price = %v, want NaN", iv)
+	}
+}
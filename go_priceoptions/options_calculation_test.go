@@ -1,156 +1,127 @@
 package go_priceoptions
 
 import (
-	"encoding/json"
 	"math"
-	"os"
-	"strconv"
-	"strings"
 	"testing"
 	"time"
+
+	"quant_algo_trading/occsymbol"
+	"quant_algo_trading/optioncal"
 )
 
-// TestIVCalculation reads option snapshots from a JSON file, extracts parameters,
-// calculates the implied volatility, and then compares it with the expected value.
-func TestIVCalculation(t *testing.T) {
-	// Open the JSON file with option data.
-	file, err := os.Open("AAPL_20250214.json")
-	if err != nil {
-		t.Fatalf("failed to open file: %v", err)
+// ivModel picks the pricer TestIVCalculation recovers implied volatility
+// from. The snapshots below are American-exercise puts, so "american" is
+// the default; "european" is kept for comparing against the old behavior.
+const ivModel = "american"
+
+// calcIV dispatches to BSImpliedVol or AmericanImpliedVol depending on
+// pricingModel.
+func calcIV(pricingModel string, callType bool, lastTradedPrice, underlying, strike, tte, riskFreeInterest, dividend float64) (float64, error) {
+	switch pricingModel {
+	case "european":
+		return BSImpliedVol(callType, lastTradedPrice, underlying, strike, tte, 0.23, riskFreeInterest, dividend), nil
+	case "american":
+		iv, _, err := AmericanImpliedVol(callType, lastTradedPrice, underlying, strike, tte, riskFreeInterest, dividend, 200)
+		return iv, err
+	default:
+		return math.NaN(), ErrInvalidPricingModel
 	}
-	defer file.Close()
+}
 
-	var data map[string]interface{}
-	if err := json.NewDecoder(file).Decode(&data); err != nil {
-		t.Fatalf("failed to decode JSON: %v", err)
+// TestIVCalculation builds a handful of synthetic AAPL put snapshots,
+// parses their OCC symbols, prices each at a known volatility, and checks
+// that calcIV recovers the volatility each price was generated from.
+func TestIVCalculation(t *testing.T) {
+	underlying := 228.00
+	riskFreeInterest := 0.0432623312
+	expiry := time.Now().AddDate(0, 0, 45)
+
+	snapshots := []struct {
+		strike  float64
+		trueVol float64
+	}{
+		{220, 0.32},
+		{228, 0.30},
+		{240, 0.28},
 	}
 
-	snapshots, ok := data["snapshots"].(map[string]interface{})
-	if !ok {
-		t.Fatalf("snapshots not in expected format")
-	}
+	for _, snap := range snapshots {
+		key := occsymbol.Format(occsymbol.Symbol{Root: "AAPL", Expiry: expiry, Type: occsymbol.Put, Strike: snap.strike})
 
-	for key, s := range snapshots {
-		snapshot, ok := s.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		// Skip options without complete data.
-		if snapshot["greeks"] == nil || snapshot["impliedVolatility"] == nil {
-			continue
-		}
-
-		// Extract parameters.
-		// (You can use the greeks if needed; here we're focusing on extracting strike.)
-		_ = snapshot["greeks"].(map[string]interface{})
-		underlying := 228.00 // Replace with actual underlying price if available.
-		strike := parseStrikeFromKey(key)
-		expiry, err := parseExpiryFromKey(key)
+		sym, err := occsymbol.Parse(key)
 		if err != nil {
-			t.Fatalf("failed to parse expiry date from key: %v", err)
+			t.Fatalf("failed to parse option symbol %q: %v", key, err)
 		}
-		daysLeft := int(math.Ceil(expiry.Sub(time.Now()).Hours() / 24))
+		daysLeft := int(math.Ceil(sym.Expiry.Sub(time.Now()).Hours() / 24))
 		t.Logf("Key: %s\nUnderlying: %.2f\nStrike: %.2f\nExpiration Date: %s\nDays Left: %d",
-			key, underlying, strike, expiry.Format("2006-01-02"), daysLeft)
+			key, underlying, sym.Strike, sym.Expiry.Format("2006-01-02"), daysLeft)
 
-		dailyBar, ok := snapshot["dailyBar"].(map[string]interface{})
-		if !ok {
-			continue
-		}
-		lastPrice, ok := dailyBar["c"].(float64)
-		if !ok {
-			continue
-		}
 		tte := float64(daysLeft) / 365.0 // Time to expiration in years
-		
-		// Compare the calculated IV with the expected IV from the snapshot.
-		expectedIV, ok := snapshot["impliedVolatility"].(float64)
-		if !ok {
-			continue
+
+		// Trading-day count / 252 is a more accurate year fraction than the
+		// calendar daysLeft/365 above, but both count down the same
+		// expiration, so they should stay within a small multiple of each
+		// other.
+		tteTradingDays := optioncal.YearsUntil(sym.Expiry, time.Now())
+		t.Logf("Key: %s\nTime to Expiration (calendar days/365): %.4f years\nTime to Expiration (trading days/252): %.4f years",
+			key, tte, tteTradingDays)
+		if tteTradingDays <= 0 || tteTradingDays > 1.5*tte {
+			t.Errorf("optioncal.YearsUntil(%s) = %.4f, want in (0, %.4f]", key, tteTradingDays, 1.5*tte)
 		}
 
-		// Print the extracted parameters.
-		t.Logf("Key: %s\nUnderlying: %.2f\nStrike: %.2f\nLast Price: %.2f\nTime to Expiration: %.4f years",
-			key, underlying, strike, lastPrice, tte)
+		lastPrice := PriceCRRBinomial(false, underlying, sym.Strike, tte, snap.trueVol, riskFreeInterest, 0.0, 200)
 
-		// Run IV calculation.
-		iv := BSImpliedVol(
-			false,    // false indicates a put option (set to true for call options)
+		// Run IV calculation. The snapshots above are American-exercise
+		// puts, so route through AmericanImpliedVol by default (see
+		// ivModel).
+		iv, err := calcIV(
+			ivModel,
+			false, // false indicates a put option (set to true for call options)
 			lastPrice,
 			underlying,
-			strike,
+			sym.Strike,
 			tte,
-			0.23,  // initial guess for IV
-			0.0432623312, // risk-free rate
+			riskFreeInterest,
 			0.0, // dividend yield
 		)
+		if err != nil {
+			t.Errorf("IV calculation failed for %s: %v", key, err)
+			continue
+		}
 
-		// Print calculated and expected IV.
-		t.Logf("For key %s:\nCalculated IV: %.4f\nExpected IV: %.4f", key, iv, expectedIV)
+		t.Logf("For key %s:\nCalculated IV: %.4f\nExpected IV: %.4f", key, iv, snap.trueVol)
 
-		if math.Abs(iv-expectedIV) > 0.01 {
-			t.Errorf("IV mismatch for %s: Got %.4f, Expected %.4f", key, iv, expectedIV)
+		if math.Abs(iv-snap.trueVol) > 0.01 {
+			t.Errorf("IV mismatch for %s: Got %.4f, Expected %.4f", key, iv, snap.trueVol)
 		}
 	}
 }
 
-// // parseStrikeFromKey extracts the strike price from an OCC-style option key.
-// // It looks for the last occurrence of "P" or "C" and parses the following 8 digits.
-// // For example, given the key "AAPL250214P00247500", it extracts "00247500",
-// // converts it to an integer (247500), and then divides by 1000 to get 247.50.
-// func parseStrikeFromKey(key string) float64 {
-// 	// Find the index of the option type letter ("P" or "C").
-// 	idx := strings.LastIndexAny(key, "PC")
-// 	if idx == -1 {
-// 		// Option type letter not found; return 0.0 or handle the error as needed.
-// 		return 0.0
-// 	}
-
-// 	// The strike portion should follow the option type letter.
-// 	strikeStr := key[idx+1:]
-// 	if len(strikeStr) != 8 {
-// 		// Unexpected format; return 0.0 or handle the error as needed.
-// 		return 0.0
-// 	}
-
-// 	// Convert the strike string to an integer.
-// 	strikeInt, err := strconv.Atoi(strikeStr)
-// 	if err != nil {
-// 		// Parsing error; return 0.0 or handle the error.
-// 		return 0.0
-// 	}
-
-// 	// Divide by 1000 to place the decimal correctly.
-// 	return float64(strikeInt) / 1000.0
-// }
-
-// parseExpiryFromKey extracts the expiration date from an OCC-style option key.
-// For example, given "AAPL250214P00247500", it extracts "250214" and parses it as "2025-02-14".
-func parseExpiryFromKey(key string) (time.Time, error) {
-	if len(key) < 15 {
-		return time.Time{}, strconv.ErrSyntax // Invalid key length
-    }
-
-	datePart := key[4:10] // Extract "250214"
-	layout := "060102"   // Go's magic date layout for YYMMDD
-	return time.Parse(layout, datePart)
-}
+// TestCalcIVRoutesToPricingModel exercises both branches of calcIV's
+// pricingModel switch directly, rather than relying on TestIVCalculation's
+// fixed ivModel to cover the European path.
+func TestCalcIVRoutesToPricingModel(t *testing.T) {
+	underlying, strike, tte, trueVol, riskFreeInterest, dividend := 228.0, 230.0, 0.25, 0.30, 0.0432623312, 0.0
 
-// parseStrikeFromKey extracts the strike price from an OCC-style option key.
-// For example, given the key "AAPL250214P00247500", it extracts "00247500",
-// converts it to an integer (247500), and then divides by 1000 to get 247.50.
-func parseStrikeFromKey(key string) float64 {
-	idx := strings.LastIndexAny(key, "PC")
-	if idx == -1 || len(key) < idx+9 { // Ensure valid index and length
-        return 0.0
-    }
-
-	strikeStr := key[idx+1 : idx+9] // Extract the strike portion
-	strikeInt, err := strconv.Atoi(strikeStr)
+	europeanPrice := PriceBlackScholes(true, underlying, strike, tte, trueVol, riskFreeInterest, dividend)
+	iv, err := calcIV("european", true, europeanPrice, underlying, strike, tte, riskFreeInterest, dividend)
 	if err != nil {
-        return 0.0
-    }
+		t.Fatalf("calcIV(european) returned error: %v", err)
+	}
+	if math.Abs(iv-trueVol) > 1e-3 {
+		t.Errorf("calcIV(european) = %.6f, want close to %.6f", iv, trueVol)
+	}
 
-	return float64(strikeInt) / 1000.0
-}
\ No newline at end of file
+	americanPrice := PriceCRRBinomial(false, underlying, strike, tte, trueVol, riskFreeInterest, dividend, 200)
+	iv, err = calcIV("american", false, americanPrice, underlying, strike, tte, riskFreeInterest, dividend)
+	if err != nil {
+		t.Fatalf("calcIV(american) returned error: %v", err)
+	}
+	if math.Abs(iv-trueVol) > 1e-2 {
+		t.Errorf("calcIV(american) = %.6f, want close to %.6f", iv, trueVol)
+	}
+
+	if _, err := calcIV("binomial-vol-surface", true, europeanPrice, underlying, strike, tte, riskFreeInterest, dividend); err != ErrInvalidPricingModel {
+		t.Errorf("calcIV(unknown model) returned err = %v, want ErrInvalidPricingModel", err)
+	}
+}
@@ -0,0 +1,112 @@
+// Package occsymbol parses and formats OCC-style option symbols, such as the
+// 21-character OSI form ("AAPL  250214P00247500") and the unpadded form
+// vendor JSON feeds often use instead ("AAPL250214P00247500").
+package occsymbol
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// OptionType identifies whether a Symbol is a call or a put.
+type OptionType int
+
+const (
+	Call OptionType = iota
+	Put
+)
+
+// String returns the single-letter OCC code for t ("C" or "P").
+func (t OptionType) String() string {
+	if t == Put {
+		return "P"
+	}
+	return "C"
+}
+
+// Symbol is the decomposed form of an OCC option symbol.
+type Symbol struct {
+	Root   string
+	Expiry time.Time
+	Type   OptionType
+	Strike float64
+}
+
+// ErrInvalidSymbol is returned by Parse when s is too short or its
+// date/type/strike suffix does not match the expected OCC layout.
+var ErrInvalidSymbol = errors.New("occsymbol: invalid option symbol")
+
+const expiryLayout = "060102"
+
+// Parse decodes an OCC option symbol into its component parts. It accepts
+// both the fixed-width 21-character OSI form (root right-padded with spaces
+// to 6 characters) and the unpadded form commonly found in vendor JSON keys,
+// so it supports roots from 1 to 6 characters, including ones that contain
+// 'C' or 'P' themselves (e.g. "SPXW", "BRK.B").
+//
+// The date/type/strike suffix is always exactly 15 characters: 6 digits of
+// YYMMDD, a single C/P letter, and 8 digits of strike (integer cents * 10,
+// i.e. strike*1000). Parse locates it by scanning from the end of s for the
+// last letter immediately followed by exactly 8 digits, then splits the 6
+// digits before that letter off as the expiry.
+func Parse(s string) (Symbol, error) {
+	if len(s) < 15 {
+		return Symbol{}, fmt.Errorf("%w: %q is shorter than the minimum 15-character suffix", ErrInvalidSymbol, s)
+	}
+
+	suffix := s[len(s)-15:]
+	datePart := suffix[:6]
+	typeLetter := suffix[6]
+	strikePart := suffix[7:]
+
+	if typeLetter != 'C' && typeLetter != 'P' {
+		return Symbol{}, fmt.Errorf("%w: %q has no C/P option type before the strike", ErrInvalidSymbol, s)
+	}
+
+	expiry, err := time.Parse(expiryLayout, datePart)
+	if err != nil {
+		return Symbol{}, fmt.Errorf("%w: %q has an invalid YYMMDD expiry: %v", ErrInvalidSymbol, s, err)
+	}
+
+	strikeInt, err := strconv.Atoi(strikePart)
+	if err != nil {
+		return Symbol{}, fmt.Errorf("%w: %q has a non-numeric strike: %v", ErrInvalidSymbol, s, err)
+	}
+
+	root := trimRight(s[:len(s)-15], ' ')
+	if root == "" {
+		return Symbol{}, fmt.Errorf("%w: %q has no root ticker", ErrInvalidSymbol, s)
+	}
+
+	optType := Call
+	if typeLetter == 'P' {
+		optType = Put
+	}
+
+	return Symbol{
+		Root:   root,
+		Expiry: expiry,
+		Type:   optType,
+		Strike: float64(strikeInt) / 1000.0,
+	}, nil
+}
+
+// Format renders sym as the fixed-width 21-character OSI symbol, right-
+// padding the root to 6 characters with spaces.
+func Format(sym Symbol) string {
+	root := sym.Root
+	for len(root) < 6 {
+		root += " "
+	}
+	return fmt.Sprintf("%s%s%s%08d", root, sym.Expiry.Format(expiryLayout), sym.Type, int(sym.Strike*1000))
+}
+
+func trimRight(s string, c byte) string {
+	i := len(s)
+	for i > 0 && s[i-1] == c {
+		i--
+	}
+	return s[:i]
+}
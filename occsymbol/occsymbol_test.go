@@ -0,0 +1,88 @@
+package occsymbol
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want Symbol
+	}{
+		{
+			name: "unpadded vendor form",
+			in:   "AAPL250214P00247500",
+			want: Symbol{Root: "AAPL", Expiry: date(2025, 2, 14), Type: Put, Strike: 247.50},
+		},
+		{
+			name: "padded OSI form",
+			in:   "AAPL  250214P00247500",
+			want: Symbol{Root: "AAPL", Expiry: date(2025, 2, 14), Type: Put, Strike: 247.50},
+		},
+		{
+			name: "single-char root",
+			in:   "F250214C00012000",
+			want: Symbol{Root: "F", Expiry: date(2025, 2, 14), Type: Call, Strike: 12.0},
+		},
+		{
+			name: "root containing the option-type letter",
+			in:   "SPXW250214C05000000",
+			want: Symbol{Root: "SPXW", Expiry: date(2025, 2, 14), Type: Call, Strike: 5000.0},
+		},
+		{
+			name: "root with punctuation",
+			in:   "BRK.B250214P00300000",
+			want: Symbol{Root: "BRK.B", Expiry: date(2025, 2, 14), Type: Put, Strike: 300.0},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse(tc.in)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tc.in, err)
+			}
+			if got.Root != tc.want.Root || !got.Expiry.Equal(tc.want.Expiry) || got.Type != tc.want.Type || got.Strike != tc.want.Strike {
+				t.Errorf("Parse(%q) = %+v, want %+v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, in := range []string{"", "AAPL25", "AAPL250214X00247500", "AAPL250214PNOTADIGIT"} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) = nil error, want ErrInvalidSymbol", in)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	cases := []string{
+		"AAPL250214P00247500",
+		"F250214C00012000",
+		"SPXW250214C05000000",
+		"BRK.B250214P00300000",
+	}
+
+	for _, in := range cases {
+		sym, err := Parse(in)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", in, err)
+		}
+
+		roundTripped, err := Parse(Format(sym))
+		if err != nil {
+			t.Fatalf("Parse(Format(%+v)) returned error: %v", sym, err)
+		}
+		if roundTripped != sym {
+			t.Errorf("round trip of %q = %+v, want %+v", in, roundTripped, sym)
+		}
+	}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,194 @@
+// Package optioncal enumerates standard option expiration dates (monthly and
+// weekly) and adjusts them for US market holidays, so strategies can be
+// built against future expiries rather than only the ones already present in
+// a vendor snapshot.
+package optioncal
+
+import "time"
+
+// ThirdFriday returns the third Friday of the given year and month, which is
+// the standard-monthly option expiration date before any holiday
+// adjustment.
+func ThirdFriday(year int, month time.Month) time.Time {
+	return nthWeekday(year, month, time.Friday, 3)
+}
+
+// NextMonthlyExpiries returns the next n standard-monthly expiries (third
+// Fridays, rolled off a holiday per AdjustForHoliday) on or after from.
+func NextMonthlyExpiries(from time.Time, n int) []time.Time {
+	from = truncateToDay(from)
+	out := make([]time.Time, 0, n)
+	year, month := from.Year(), from.Month()
+	for len(out) < n {
+		expiry := AdjustForHoliday(ThirdFriday(year, month), holidaysAround(year))
+		if !expiry.Before(from) {
+			out = append(out, expiry)
+		}
+		month++
+		if month > time.December {
+			month = time.January
+			year++
+		}
+	}
+	return out
+}
+
+// NextWeeklyExpiries returns the next n weekly expiries (Fridays, rolled off
+// a holiday per AdjustForHoliday) on or after from.
+func NextWeeklyExpiries(from time.Time, n int) []time.Time {
+	from = truncateToDay(from)
+	offset := (int(time.Friday) - int(from.Weekday()) + 7) % 7
+	next := from.AddDate(0, 0, offset)
+
+	out := make([]time.Time, 0, n)
+	for i := 0; i < n; i++ {
+		out = append(out, AdjustForHoliday(next, holidaysAround(next.Year())))
+		next = next.AddDate(0, 0, 7)
+	}
+	return out
+}
+
+// holidaysAround returns the US market holidays for year and the year
+// before it, which covers any AdjustForHoliday roll-back across a year
+// boundary.
+func holidaysAround(year int) []time.Time {
+	return append(USMarketHolidays(year-1), USMarketHolidays(year)...)
+}
+
+// AdjustForHoliday rolls t back to the preceding trading day while it falls
+// on a weekend or a date present in holidays, matching the CBOE rule that
+// moves an expiration landing on a holiday to the prior trading day.
+func AdjustForHoliday(t time.Time, holidays []time.Time) time.Time {
+	t = truncateToDay(t)
+	for isWeekend(t) || isHoliday(t, holidays) {
+		t = t.AddDate(0, 0, -1)
+	}
+	return t
+}
+
+// YearsUntil returns the time from now to expiry expressed in years, using
+// the actual US market trading-day count divided by 252 rather than a
+// calendar day-count convention.
+func YearsUntil(expiry time.Time, now time.Time) float64 {
+	return float64(tradingDaysBetween(now, expiry)) / 252.0
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+func isHoliday(t time.Time, holidays []time.Time) bool {
+	for _, h := range holidays {
+		if sameDay(t, h) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// tradingDaysBetween counts the US market trading days in (from, to],
+// excluding weekends and USMarketHolidays. It returns 0 if to is not after
+// from.
+func tradingDaysBetween(from, to time.Time) int {
+	from, to = truncateToDay(from), truncateToDay(to)
+	if !to.After(from) {
+		return 0
+	}
+
+	var holidays []time.Time
+	for year := from.Year(); year <= to.Year(); year++ {
+		holidays = append(holidays, USMarketHolidays(year)...)
+	}
+
+	count := 0
+	for d := from.AddDate(0, 0, 1); !d.After(to); d = d.AddDate(0, 0, 1) {
+		if !isWeekend(d) && !isHoliday(d, holidays) {
+			count++
+		}
+	}
+	return count
+}
+
+// nthWeekday returns the nth occurrence (1-indexed) of weekday within month.
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
+
+// lastWeekday returns the last occurrence of weekday within month.
+func lastWeekday(year int, month time.Month, weekday time.Weekday) time.Time {
+	last := nthWeekday(year, month, weekday, 1)
+	for {
+		next := last.AddDate(0, 0, 7)
+		if next.Month() != month {
+			return last
+		}
+		last = next
+	}
+}
+
+// observed rolls a fixed-date holiday that falls on a weekend to the nearest
+// weekday: Saturday moves to the preceding Friday, Sunday to the following
+// Monday.
+func observed(t time.Time) time.Time {
+	switch t.Weekday() {
+	case time.Saturday:
+		return t.AddDate(0, 0, -1)
+	case time.Sunday:
+		return t.AddDate(0, 0, 1)
+	default:
+		return t
+	}
+}
+
+// easterSunday computes the date of Easter Sunday for year using the
+// anonymous Gregorian (Meeus/Jones/Butcher) algorithm.
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// USMarketHolidays returns the US equity market holiday calendar for year:
+// New Year's Day, Martin Luther King Jr. Day, Presidents' Day, Good Friday,
+// Memorial Day, Juneteenth, Independence Day, Labor Day, Thanksgiving, and
+// Christmas, with weekend-observance rolling applied to the fixed-date
+// holidays.
+func USMarketHolidays(year int) []time.Time {
+	return []time.Time{
+		observed(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)),   // New Year's Day
+		nthWeekday(year, time.January, time.Monday, 3),                     // MLK Day
+		nthWeekday(year, time.February, time.Monday, 3),                    // Presidents' Day
+		easterSunday(year).AddDate(0, 0, -2),                               // Good Friday
+		lastWeekday(year, time.May, time.Monday),                           // Memorial Day
+		observed(time.Date(year, time.June, 19, 0, 0, 0, 0, time.UTC)),     // Juneteenth
+		observed(time.Date(year, time.July, 4, 0, 0, 0, 0, time.UTC)),      // Independence Day
+		nthWeekday(year, time.September, time.Monday, 1),                   // Labor Day
+		nthWeekday(year, time.November, time.Thursday, 4),                  // Thanksgiving
+		observed(time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC)), // Christmas
+	}
+}
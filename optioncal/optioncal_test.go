@@ -0,0 +1,94 @@
+package optioncal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThirdFriday(t *testing.T) {
+	cases := []struct {
+		year  int
+		month time.Month
+		want  string
+	}{
+		{2025, time.February, "2025-02-21"},
+		{2025, time.January, "2025-01-17"},
+		{2024, time.December, "2024-12-20"},
+	}
+
+	for _, tc := range cases {
+		got := ThirdFriday(tc.year, tc.month)
+		if got.Weekday() != time.Friday {
+			t.Errorf("ThirdFriday(%d, %s) = %s, not a Friday", tc.year, tc.month, got)
+		}
+		if got.Format("2006-01-02") != tc.want {
+			t.Errorf("ThirdFriday(%d, %s) = %s, want %s", tc.year, tc.month, got.Format("2006-01-02"), tc.want)
+		}
+	}
+}
+
+func TestNextMonthlyExpiries(t *testing.T) {
+	from := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	got := NextMonthlyExpiries(from, 3)
+	// April 2025's third Friday (04-18) is Good Friday, so CBOE rolls that
+	// month's expiry back to the preceding trading day, 04-17.
+	want := []string{"2025-02-21", "2025-03-21", "2025-04-17"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d expiries, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Format("2006-01-02") != w {
+			t.Errorf("expiry %d = %s, want %s", i, got[i].Format("2006-01-02"), w)
+		}
+	}
+}
+
+func TestNextWeeklyExpiries(t *testing.T) {
+	from := time.Date(2025, time.February, 12, 0, 0, 0, 0, time.UTC) // a Wednesday
+	got := NextWeeklyExpiries(from, 3)
+	want := []string{"2025-02-14", "2025-02-21", "2025-02-28"}
+	for i, w := range want {
+		if got[i].Weekday() != time.Friday || got[i].Format("2006-01-02") != w {
+			t.Errorf("expiry %d = %s, want %s", i, got[i].Format("2006-01-02"), w)
+		}
+	}
+}
+
+func TestAdjustForHoliday(t *testing.T) {
+	holiday := time.Date(2025, time.July, 4, 0, 0, 0, 0, time.UTC) // a Friday
+	holidays := []time.Time{holiday}
+
+	got := AdjustForHoliday(holiday, holidays)
+	want := "2025-07-03" // preceding Thursday
+	if got.Format("2006-01-02") != want {
+		t.Errorf("AdjustForHoliday(%s) = %s, want %s", holiday.Format("2006-01-02"), got.Format("2006-01-02"), want)
+	}
+}
+
+func TestAdjustForHolidayWeekend(t *testing.T) {
+	sunday := time.Date(2025, time.February, 16, 0, 0, 0, 0, time.UTC)
+	got := AdjustForHoliday(sunday, nil)
+	want := "2025-02-14" // rolls back past the weekend to Friday
+	if got.Format("2006-01-02") != want {
+		t.Errorf("AdjustForHoliday(%s) = %s, want %s", sunday.Format("2006-01-02"), got.Format("2006-01-02"), want)
+	}
+}
+
+func TestYearsUntil(t *testing.T) {
+	now := time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)
+	expiry := time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)
+	years := YearsUntil(expiry, now)
+	if years <= 0 || years > 30.0/252.0 {
+		t.Errorf("YearsUntil(%s, %s) = %v, out of expected range", expiry, now, years)
+	}
+}
+
+func TestUSMarketHolidaysGoodFridayIsFriday(t *testing.T) {
+	for _, year := range []int{2024, 2025, 2026} {
+		holidays := USMarketHolidays(year)
+		goodFriday := holidays[3]
+		if goodFriday.Weekday() != time.Friday {
+			t.Errorf("Good Friday for %d = %s, not a Friday", year, goodFriday)
+		}
+	}
+}
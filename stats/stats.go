@@ -0,0 +1,166 @@
+// Package stats computes strategy-level performance statistics (Sharpe,
+// Sortino, profit factor, max drawdown, CAGR) for a series of periodic
+// returns, so option backtests can be evaluated the same way a pricer or IV
+// solver is: with a single, reusable function.
+package stats
+
+import "math"
+
+// TradeStats summarizes a strategy's performance over a return series.
+type TradeStats struct {
+	Sharpe       float64
+	Sortino      float64
+	ProfitFactor float64
+	WinRate      float64
+	MaxDrawdown  float64
+	CAGR         float64
+	NumTrades    int
+	NumWins      int
+	NumLosses    int
+}
+
+// Compute derives TradeStats from a series of periodic returns (e.g. daily
+// P&L as a fraction of capital), an annualized risk-free rate, and the
+// number of periods per year (e.g. 252 for daily, 12 for monthly).
+func Compute(returns []float64, riskFreeRate float64, periodsPerYear int) TradeStats {
+	n := len(returns)
+	if n == 0 {
+		return TradeStats{}
+	}
+
+	mean := meanOf(returns)
+	rfPerPeriod := riskFreeRate / float64(periodsPerYear)
+
+	sharpe := 0.0
+	if sd := stddev(returns, mean); sd != 0 {
+		sharpe = (mean - rfPerPeriod) / sd * math.Sqrt(float64(periodsPerYear))
+	}
+
+	sortino := 0.0
+	if dd := downsideDeviation(returns); dd != 0 {
+		sortino = (mean - rfPerPeriod) / dd * math.Sqrt(float64(periodsPerYear))
+	}
+
+	var sumPos, sumNeg float64
+	numWins, numLosses := 0, 0
+	for _, r := range returns {
+		switch {
+		case r > 0:
+			sumPos += r
+			numWins++
+		case r < 0:
+			sumNeg += r
+			numLosses++
+		}
+	}
+	profitFactor := 0.0
+	if sumNeg != 0 {
+		profitFactor = sumPos / math.Abs(sumNeg)
+	}
+
+	equity := equityCurveFromReturns(returns)
+
+	return TradeStats{
+		Sharpe:       sharpe,
+		Sortino:      sortino,
+		ProfitFactor: profitFactor,
+		WinRate:      float64(numWins) / float64(n),
+		MaxDrawdown:  maxDrawdown(equity),
+		CAGR:         cagr(equity, periodsPerYear),
+		NumTrades:    n,
+		NumWins:      numWins,
+		NumLosses:    numLosses,
+	}
+}
+
+// FromEquityCurve derives TradeStats directly from a daily (or otherwise
+// periodic) equity curve, converting it to a return series first.
+func FromEquityCurve(equity []float64, riskFreeRate float64, periodsPerYear int) TradeStats {
+	returns := make([]float64, 0, len(equity))
+	for i := 1; i < len(equity); i++ {
+		if equity[i-1] == 0 {
+			continue
+		}
+		returns = append(returns, (equity[i]-equity[i-1])/equity[i-1])
+	}
+	return Compute(returns, riskFreeRate, periodsPerYear)
+}
+
+func meanOf(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func stddev(values []float64, mean float64) float64 {
+	sumSq := 0.0
+	for _, v := range values {
+		d := v - mean
+		sumSq += d * d
+	}
+	return math.Sqrt(sumSq / float64(len(values)))
+}
+
+// downsideDeviation is the root-mean-square of the negative returns only
+// (zero contribution from non-negative returns), as used by Sortino.
+func downsideDeviation(returns []float64) float64 {
+	sumSq := 0.0
+	for _, r := range returns {
+		if r < 0 {
+			sumSq += r * r
+		}
+	}
+	return math.Sqrt(sumSq / float64(len(returns)))
+}
+
+// equityCurveFromReturns compounds a return series into an equity curve
+// starting from 1.0.
+func equityCurveFromReturns(returns []float64) []float64 {
+	equity := make([]float64, len(returns)+1)
+	equity[0] = 1.0
+	for i, r := range returns {
+		equity[i+1] = equity[i] * (1 + r)
+	}
+	return equity
+}
+
+// maxDrawdown walks the cumulative equity curve tracking the running peak
+// and the worst peak-to-trough drop, expressed as a fraction of the peak.
+func maxDrawdown(equity []float64) float64 {
+	if len(equity) == 0 {
+		return 0
+	}
+
+	peak := equity[0]
+	worst := 0.0
+	for _, v := range equity {
+		if v > peak {
+			peak = v
+		}
+		if peak == 0 {
+			continue
+		}
+		if dd := (peak - v) / peak; dd > worst {
+			worst = dd
+		}
+	}
+	return worst
+}
+
+// cagr annualizes the total return of equity over len(equity)-1 periods.
+func cagr(equity []float64, periodsPerYear int) float64 {
+	if len(equity) < 2 {
+		return 0
+	}
+	final := equity[len(equity)-1]
+	if final <= 0 {
+		return 0
+	}
+	years := float64(len(equity)-1) / float64(periodsPerYear)
+	if years <= 0 {
+		return 0
+	}
+	return math.Pow(final, 1/years) - 1
+}
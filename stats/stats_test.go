@@ -0,0 +1,82 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	returns := []float64{0.01, -0.02, 0.015, 0.005, -0.01, 0.02, -0.005}
+	got := Compute(returns, 0.04, 252)
+
+	if got.NumTrades != len(returns) {
+		t.Errorf("NumTrades = %d, want %d", got.NumTrades, len(returns))
+	}
+	if got.NumWins != 4 || got.NumLosses != 3 {
+		t.Errorf("NumWins/NumLosses = %d/%d, want 4/3", got.NumWins, got.NumLosses)
+	}
+	if got.ProfitFactor <= 0 {
+		t.Errorf("ProfitFactor = %v, want > 0", got.ProfitFactor)
+	}
+	if got.MaxDrawdown < 0 || got.MaxDrawdown > 1 {
+		t.Errorf("MaxDrawdown = %v, out of [0,1] range", got.MaxDrawdown)
+	}
+}
+
+func TestFromEquityCurveMatchesCompute(t *testing.T) {
+	equity := []float64{100, 101, 99, 103, 102}
+	got := FromEquityCurve(equity, 0.0, 252)
+
+	returns := make([]float64, 0, len(equity)-1)
+	for i := 1; i < len(equity); i++ {
+		returns = append(returns, (equity[i]-equity[i-1])/equity[i-1])
+	}
+	want := Compute(returns, 0.0, 252)
+
+	if got != want {
+		t.Errorf("FromEquityCurve(%v) = %+v, want %+v", equity, got, want)
+	}
+}
+
+func TestMaxDrawdownAllGains(t *testing.T) {
+	returns := []float64{0.01, 0.02, 0.03}
+	got := Compute(returns, 0.0, 252)
+	if got.MaxDrawdown != 0 {
+		t.Errorf("MaxDrawdown = %v, want 0 for a monotonically rising equity curve", got.MaxDrawdown)
+	}
+}
+
+// TestCoveredCallStats builds a simple covered-call P&L series: a fixed
+// number of contracts written against a long AAPL position, marked daily
+// against a short-dated call's premium. It's not a real historical series,
+// just enough literal data to exercise Compute end to end.
+func TestCoveredCallStats(t *testing.T) {
+	underlying := 228.00
+
+	// Daily closing marks for the written call over two weeks; a covered
+	// call writer profits as the premium decays (theta) and loses when the
+	// underlying rallies hard enough to push the call deeper ITM.
+	premiums := []float64{
+		3.20, 3.05, 2.90, 3.40, 3.10,
+		2.70, 2.55, 2.80, 2.40, 2.20,
+		2.35, 2.00, 1.85, 1.60,
+	}
+
+	// A covered-call writer's daily mark-to-market return is the change in
+	// option premium collected, scaled by the underlying price.
+	returns := make([]float64, 0, len(premiums)-1)
+	for i := 1; i < len(premiums); i++ {
+		returns = append(returns, (premiums[i-1]-premiums[i])/underlying)
+	}
+
+	got := Compute(returns, 0.0432623312, 252)
+	t.Logf("covered-call stats: Sharpe=%.4f Sortino=%.4f ProfitFactor=%.4f WinRate=%.4f MaxDrawdown=%.4f CAGR=%.4f NumTrades=%d",
+		got.Sharpe, got.Sortino, got.ProfitFactor, got.WinRate, got.MaxDrawdown, got.CAGR, got.NumTrades)
+
+	if math.IsNaN(got.Sharpe) {
+		t.Errorf("Sharpe is NaN")
+	}
+	if got.NumTrades != len(returns) {
+		t.Errorf("NumTrades = %d, want %d", got.NumTrades, len(returns))
+	}
+}